@@ -0,0 +1,141 @@
+// Package parallel provides parallel variants of a few of the root slicetricks package's helpers, for use
+// when the per-element work is expensive enough that splitting it across goroutines pays for itself.
+//
+// Every function takes a workers argument controlling how many goroutines to fan out across; 0 means
+// runtime.GOMAXPROCS(0). Callers with cheap predicates (a comparison, a map lookup) should prefer the
+// sequential versions in the root package - the overhead of spawning goroutines and merging results will
+// usually dwarf any savings.
+package parallel
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// chunks splits [0, n) into workers contiguous, roughly-equal ranges, skipping any that would be empty.
+func chunks(n, workers int) [][2]int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		if n == 0 {
+			return nil
+		}
+		return [][2]int{{0, n}}
+	}
+
+	size := (n + workers - 1) / workers
+	ranges := make([][2]int, 0, workers)
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
+
+// FilterP returns the elements of a for which keep returns true, in a newly allocated slice, using workers
+// goroutines. Results are produced in the same order as the sequential Filter would produce them.
+func FilterP[T any](a []T, keep func(t T) bool, workers int) []T {
+	ranges := chunks(len(a), workers)
+
+	results := make([][]T, len(ranges))
+	var wg sync.WaitGroup
+	for i, r := range ranges {
+		wg.Add(1)
+		go func(i int, r [2]int) {
+			defer wg.Done()
+
+			var out []T
+			for _, x := range a[r[0]:r[1]] {
+				if keep(x) {
+					out = append(out, x)
+				}
+			}
+			results[i] = out
+		}(i, r)
+	}
+	wg.Wait()
+
+	n := 0
+	for _, r := range results {
+		n += len(r)
+	}
+	out := make([]T, 0, n)
+	for _, r := range results {
+		out = append(out, r...)
+	}
+	return out
+}
+
+// MapP returns a newly allocated slice containing f applied to each element of a, using workers goroutines.
+func MapP[T, U any](a []T, f func(t T) U, workers int) []U {
+	out := make([]U, len(a))
+
+	ranges := chunks(len(a), workers)
+	var wg sync.WaitGroup
+	for _, r := range ranges {
+		wg.Add(1)
+		go func(r [2]int) {
+			defer wg.Done()
+			for i := r[0]; i < r[1]; i++ {
+				out[i] = f(a[i])
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	return out
+}
+
+// AnyP reports whether f returns true for any element of a, using workers goroutines. Workers stop
+// scanning as soon as one of them finds a match.
+func AnyP[T any](a []T, f func(t T) bool, workers int) bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var found sync.Once
+	var result bool
+
+	ranges := chunks(len(a), workers)
+	var wg sync.WaitGroup
+	for _, r := range ranges {
+		wg.Add(1)
+		go func(r [2]int) {
+			defer wg.Done()
+			for i := r[0]; i < r[1]; i++ {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				if f(a[i]) {
+					found.Do(func() { result = true })
+					cancel()
+					return
+				}
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	return result
+}
+
+// AllP reports whether f returns true for every element of a, using workers goroutines. Workers stop
+// scanning as soon as one of them finds a counterexample.
+func AllP[T any](a []T, f func(t T) bool, workers int) bool {
+	return !AnyP(a, func(t T) bool { return !f(t) }, workers)
+}
+
+// NoneP reports whether f returns false for every element of a, using workers goroutines.
+func NoneP[T any](a []T, f func(t T) bool, workers int) bool {
+	return !AnyP(a, f, workers)
+}
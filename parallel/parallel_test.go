@@ -0,0 +1,110 @@
+package parallel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jamespwilliams/slicetricks"
+)
+
+func TestFilterP(t *testing.T) {
+	x := make([]int, 100)
+	for i := range x {
+		x[i] = i
+	}
+
+	for _, workers := range []int{0, 1, 3, 100} {
+		got := FilterP(x, func(i int) bool { return i%2 == 0 }, workers)
+
+		want := make([]int, 0, 50)
+		for i := 0; i < 100; i += 2 {
+			want = append(want, i)
+		}
+		assert.Equal(t, want, got, "workers=%d", workers)
+	}
+}
+
+func TestFilterPEmpty(t *testing.T) {
+	got := FilterP([]int{}, func(int) bool { return true }, 4)
+	assert.Empty(t, got)
+}
+
+func TestMapP(t *testing.T) {
+	x := []int{1, 2, 3, 4, 5}
+	got := MapP(x, func(i int) int { return i * i }, 3)
+	assert.Equal(t, []int{1, 4, 9, 16, 25}, got)
+}
+
+func TestAnyP(t *testing.T) {
+	x := []int{1, 3, 5, 6, 7}
+	assert.True(t, AnyP(x, func(i int) bool { return i%2 == 0 }, 4))
+	assert.True(t, AnyP(x, func(i int) bool { return i%2 == 0 }, 0))
+
+	x = []int{1, 3, 5, 7}
+	assert.False(t, AnyP(x, func(i int) bool { return i%2 == 0 }, 4))
+}
+
+func TestAllP(t *testing.T) {
+	x := []int{2, 4, 6, 8}
+	assert.True(t, AllP(x, func(i int) bool { return i%2 == 0 }, 4))
+
+	x = []int{2, 4, 5, 8}
+	assert.False(t, AllP(x, func(i int) bool { return i%2 == 0 }, 4))
+}
+
+func TestNoneP(t *testing.T) {
+	x := []int{1, 3, 5, 7}
+	assert.True(t, NoneP(x, func(i int) bool { return i%2 == 0 }, 4))
+
+	x = []int{1, 3, 4, 7}
+	assert.False(t, NoneP(x, func(i int) bool { return i%2 == 0 }, 4))
+}
+
+func benchInput(n int) []int {
+	x := make([]int, n)
+	for i := range x {
+		x[i] = i
+	}
+	return x
+}
+
+func cheapPredicate(i int) bool { return i%2 == 0 }
+
+// expensivePredicate simulates a predicate that does real work (e.g. a network call or heavy computation),
+// where the cost of parallelising pays for itself.
+func expensivePredicate(i int) bool {
+	time.Sleep(time.Microsecond)
+	return i%2 == 0
+}
+
+func BenchmarkFilterCheap(b *testing.B) {
+	x := benchInput(10_000)
+	for i := 0; i < b.N; i++ {
+		y := slicetricks.Copy(x)
+		slicetricks.Filter(&y, cheapPredicate)
+	}
+}
+
+func BenchmarkFilterPCheap(b *testing.B) {
+	x := benchInput(10_000)
+	for i := 0; i < b.N; i++ {
+		FilterP(x, cheapPredicate, 0)
+	}
+}
+
+func BenchmarkFilterExpensive(b *testing.B) {
+	x := benchInput(100)
+	for i := 0; i < b.N; i++ {
+		y := slicetricks.Copy(x)
+		slicetricks.Filter(&y, expensivePredicate)
+	}
+}
+
+func BenchmarkFilterPExpensive(b *testing.B) {
+	x := benchInput(100)
+	for i := 0; i < b.N; i++ {
+		FilterP(x, expensivePredicate, 0)
+	}
+}
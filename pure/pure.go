@@ -0,0 +1,144 @@
+// Package pure provides immutable counterparts to the mutating helpers in the root slicetricks package.
+// Every function here takes its input slice by value, never modifies it, and returns a new slice holding
+// the result. Where the equivalent mutating function would need to grow its backing array, these functions
+// pre-size the destination exactly (usually with a single counting pass) so that each call performs at most
+// one allocation. SortAndDeduplicate is the exception: it also allocates a permutation of indices to sort.
+package pure
+
+import (
+	"sort"
+)
+
+// Filter returns the elements of a for which keep returns true, in a newly allocated slice.
+func Filter[T any](a []T, keep func(t T) bool) []T {
+	n := 0
+	for _, x := range a {
+		if keep(x) {
+			n++
+		}
+	}
+
+	out := make([]T, 0, n)
+	for _, x := range a {
+		if keep(x) {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+// Insert returns a copy of a with elem inserted at index i.
+func Insert[T any](a []T, i int, elem T) []T {
+	out := make([]T, len(a)+1)
+	copy(out, a[:i])
+	out[i] = elem
+	copy(out[i+1:], a[i:])
+	return out
+}
+
+// InsertMany returns a copy of a with elems inserted at index i.
+func InsertMany[T any](a []T, i int, elems ...T) []T {
+	out := make([]T, len(a)+len(elems))
+	copy(out, a[:i])
+	copy(out[i:], elems)
+	copy(out[i+len(elems):], a[i:])
+	return out
+}
+
+// Delete returns a copy of a with the i'th element removed.
+func Delete[T any](a []T, i int) []T {
+	out := make([]T, len(a)-1)
+	copy(out, a[:i])
+	copy(out[i:], a[i+1:])
+	return out
+}
+
+// DeleteUnordered is a faster alternative to Delete if you don't care about the order of the result.
+func DeleteUnordered[T any](a []T, i int) []T {
+	out := make([]T, len(a)-1)
+	copy(out, a[:len(a)-1])
+	if i < len(out) {
+		out[i] = a[len(a)-1]
+	}
+	return out
+}
+
+// Cut returns a copy of a with the elements starting at start and ending at end (non-inclusive) removed. It
+// panics if start or end are out of range.
+func Cut[T any](a []T, start, end int) []T {
+	_ = a[start:end]
+
+	out := make([]T, len(a)-(end-start))
+	copy(out, a[:start])
+	copy(out[start:], a[end:])
+	return out
+}
+
+// Expand returns a copy of a with n elements of the zero value of T inserted after the i'th element.
+func Expand[T any](a []T, i, n int) []T {
+	out := make([]T, len(a)+n)
+	copy(out, a[:i])
+	copy(out[i+n:], a[i:])
+	return out
+}
+
+// Extend returns a copy of a with n elements of the zero value of T appended to the end.
+func Extend[T any](a []T, n int) []T {
+	out := make([]T, len(a)+n)
+	copy(out, a)
+	return out
+}
+
+// Push returns a copy of a with elem appended to the end.
+func Push[T any](a []T, elem T) []T {
+	out := make([]T, len(a)+1)
+	copy(out, a)
+	out[len(a)] = elem
+	return out
+}
+
+// PushFront returns a copy of a with elem inserted at the start.
+func PushFront[T any](a []T, elem T) []T {
+	out := make([]T, len(a)+1)
+	out[0] = elem
+	copy(out[1:], a)
+	return out
+}
+
+// Reverse returns a copy of a with the elements in reverse order.
+func Reverse[T any](a []T) []T {
+	out := make([]T, len(a))
+	for i, x := range a {
+		out[len(a)-1-i] = x
+	}
+	return out
+}
+
+// SortAndDeduplicate returns a sorted copy of a with duplicate elements removed. less is given indices
+// into a, exactly as in the mutating SortAndDeduplicate, rather than indices into the result.
+func SortAndDeduplicate[T comparable](a []T, less func(i, j int) bool) []T {
+	if len(a) == 0 {
+		return make([]T, 0)
+	}
+
+	perm := make([]int, len(a))
+	for i := range perm {
+		perm[i] = i
+	}
+	sort.SliceStable(perm, func(i, j int) bool { return less(perm[i], perm[j]) })
+
+	out := make([]T, len(a))
+	for i, p := range perm {
+		out[i] = a[p]
+	}
+
+	j := 0
+	for i := 1; i < len(out); i++ {
+		if out[j] == out[i] {
+			continue
+		}
+		j++
+		out[j] = out[i]
+	}
+	return out[:j+1]
+}
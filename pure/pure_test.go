@@ -0,0 +1,97 @@
+package pure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := Filter(x, func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{0, 2}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestInsert(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := Insert(x, 2, 9)
+	assert.Equal(t, []int{0, 1, 9, 2, 3}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestInsertMany(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := InsertMany(x, 2, 7, 8, 9)
+	assert.Equal(t, []int{0, 1, 7, 8, 9, 2, 3}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestDelete(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := Delete(x, 2)
+	assert.Equal(t, []int{0, 1, 3}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestDeleteUnordered(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := DeleteUnordered(x, 0)
+	assert.ElementsMatch(t, []int{1, 2, 3}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestCut(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := Cut(x, 1, 3)
+	assert.Equal(t, []int{0, 3}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestExpand(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := Expand(x, 2, 3)
+	assert.Equal(t, []int{0, 1, 0, 0, 0, 2, 3}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestExtend(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+	y := Extend(x, 2)
+	assert.Equal(t, []int{0, 1, 2, 3, 0, 0}, y)
+	assert.Equal(t, []int{0, 1, 2, 3}, x)
+}
+
+func TestPush(t *testing.T) {
+	x := []int{0, 1, 2}
+	y := Push(x, 3)
+	assert.Equal(t, []int{0, 1, 2, 3}, y)
+	assert.Equal(t, []int{0, 1, 2}, x)
+}
+
+func TestPushFront(t *testing.T) {
+	x := []int{0, 1, 2}
+	y := PushFront(x, 3)
+	assert.Equal(t, []int{3, 0, 1, 2}, y)
+	assert.Equal(t, []int{0, 1, 2}, x)
+}
+
+func TestReverse(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+	y := Reverse(x)
+	assert.Equal(t, []int{4, 3, 2, 1, 0}, y)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, x)
+}
+
+func TestSortAndDeduplicate(t *testing.T) {
+	x := []int{9, 3, 3, 4, 6, 3, 6, 9, 3, 5}
+	y := SortAndDeduplicate(x, func(i, j int) bool { return x[i] < x[j] })
+	assert.Equal(t, []int{3, 4, 5, 6, 9}, y)
+	assert.Equal(t, []int{9, 3, 3, 4, 6, 3, 6, 9, 3, 5}, x)
+}
+
+func TestSortAndDeduplicateEmpty(t *testing.T) {
+	var x []int
+	y := SortAndDeduplicate(x, func(i, j int) bool { return x[i] < x[j] })
+	assert.Empty(t, y)
+}
@@ -0,0 +1,230 @@
+// Package slices provides slice operations with an API shaped like the standard library's slices package
+// (https://pkg.go.dev/slices): functions are generic over ~[]E, take the slice by value, and return the
+// (possibly reallocated) result rather than mutating through a *[]E. Its set of functions follows the
+// standard library's rather than the root slicetricks package's; helpers such as Batches or Push are not here.
+//
+// This is the recommended entry point for new code. The pointer-based functions in the root package remain
+// for callers who already depend on them, and for the cases where in-place mutation avoids an allocation.
+package slices
+
+import (
+	"cmp"
+	"sort"
+)
+
+// Clone returns a copy of s. The elements are copied using assignment, so this is a shallow clone.
+func Clone[S ~[]E, E any](s S) S {
+	if s == nil {
+		return nil
+	}
+	return append(S{}, s...)
+}
+
+// Clip removes unused capacity from s, returning s[:len(s):len(s)].
+func Clip[S ~[]E, E any](s S) S {
+	return s[:len(s):len(s)]
+}
+
+// Grow grows s's capacity by reallocating, if necessary, to guarantee space for at least n more elements.
+// After Grow(s, n), at least n elements can be appended to the returned slice without another allocation.
+// Grow panics if n is negative.
+func Grow[S ~[]E, E any](s S, n int) S {
+	if n < 0 {
+		panic("cannot be negative")
+	}
+
+	if n -= cap(s) - len(s); n > 0 {
+		s = append(s[:cap(s)], make(S, n)...)[:len(s)]
+	}
+	return s
+}
+
+// Concat returns a new slice containing the elements of ss concatenated together, in order.
+func Concat[S ~[]E, E any](ss ...S) S {
+	n := 0
+	for _, s := range ss {
+		n += len(s)
+	}
+	out := make(S, 0, n)
+	for _, s := range ss {
+		out = append(out, s...)
+	}
+	return out
+}
+
+// Delete removes s[i:j] from s, returning the modified slice. Delete panics if s[i:j] is not a valid slice
+// of s. The removed elements are zeroed so that they can be garbage collected.
+func Delete[S ~[]E, E any](s S, i, j int) S {
+	_ = s[i:j]
+
+	var zero E
+	n := copy(s[i:], s[j:])
+	for k := i + n; k < len(s); k++ {
+		s[k] = zero
+	}
+
+	return s[:len(s)-(j-i)]
+}
+
+// DeleteFunc removes any elements from s for which del returns true, preserving the order of the remaining
+// elements, and returns the modified slice. The removed elements are zeroed so that they can be garbage
+// collected.
+func DeleteFunc[S ~[]E, E any](s S, del func(E) bool) S {
+	n := 0
+	for _, x := range s {
+		if !del(x) {
+			s[n] = x
+			n++
+		}
+	}
+
+	var zero E
+	for k := n; k < len(s); k++ {
+		s[k] = zero
+	}
+
+	return s[:n]
+}
+
+// Insert inserts v... into s at index i, returning the modified slice. The elements at s[i:] are shifted
+// up to make room.
+func Insert[S ~[]E, E any](s S, i int, v ...E) S {
+	if len(v) == 0 {
+		return s
+	}
+
+	n := len(s) + len(v)
+	if n <= cap(s) {
+		s2 := s[:n]
+		copy(s2[i+len(v):], s[i:])
+		copy(s2[i:], v)
+		return s2
+	}
+
+	s2 := make(S, n)
+	copy(s2, s[:i])
+	copy(s2[i:], v)
+	copy(s2[i+len(v):], s[i:])
+	return s2
+}
+
+// Replace replaces the elements s[i:j] with v..., returning the modified slice.
+func Replace[S ~[]E, E any](s S, i, j int, v ...E) S {
+	s = Delete(s, i, j)
+	return Insert(s, i, v...)
+}
+
+// Compact replaces consecutive runs of equal elements with a single copy, as in the Unix uniq command. It
+// only removes consecutive duplicates; to remove all duplicates, sort the slice first.
+func Compact[S ~[]E, E comparable](s S) S {
+	return CompactFunc(s, func(a, b E) bool { return a == b })
+}
+
+// CompactFunc is like Compact, but uses eq to compare elements.
+func CompactFunc[S ~[]E, E any](s S, eq func(E, E) bool) S {
+	if len(s) < 2 {
+		return s
+	}
+
+	j := 0
+	for i := 1; i < len(s); i++ {
+		if !eq(s[j], s[i]) {
+			j++
+			s[j] = s[i]
+		}
+	}
+	j++
+
+	var zero E
+	for k := j; k < len(s); k++ {
+		s[k] = zero
+	}
+
+	return s[:j]
+}
+
+// Equal reports whether s1 and s2 are equal: the same length and all elements equal.
+func Equal[S ~[]E, E comparable](s1, s2 S) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc is like Equal, but uses eq to compare elements. Elements are compared in index order, and the
+// comparison stops at the first index for which eq returns false.
+func EqualFunc[S1 ~[]E1, S2 ~[]E2, E1, E2 any](s1 S1, s2 S2, eq func(E1, E2) bool) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if !eq(s1[i], s2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Index returns the index of the first occurrence of v in s, or -1 if not present.
+func Index[S ~[]E, E comparable](s S, v E) int {
+	return IndexFunc(s, func(e E) bool { return e == v })
+}
+
+// IndexFunc returns the index of the first element in s for which f returns true, or -1 if none do.
+func IndexFunc[S ~[]E, E any](s S, f func(E) bool) int {
+	for i, e := range s {
+		if f(e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether v is present in s.
+func Contains[S ~[]E, E comparable](s S, v E) bool {
+	return Index(s, v) >= 0
+}
+
+// ContainsFunc reports whether at least one element in s satisfies f.
+func ContainsFunc[S ~[]E, E any](s S, f func(E) bool) bool {
+	return IndexFunc(s, f) >= 0
+}
+
+// BinarySearch searches for target in a sorted (in ascending order) slice s, returning the index at which
+// target is found, or the index at which target would be inserted if it is not present, and whether target
+// was found.
+func BinarySearch[S ~[]E, E cmp.Ordered](s S, target E) (int, bool) {
+	return BinarySearchFunc(s, target, cmp.Compare[E])
+}
+
+// BinarySearchFunc is like BinarySearch, but uses a custom comparison function. It requires that s is
+// sorted in ascending order, as defined by cmp(s[i], target) for increasing i.
+func BinarySearchFunc[S ~[]E, E, T any](s S, target T, cmp func(E, T) int) (int, bool) {
+	n := len(s)
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if cmp(s[h], target) < 0 {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i, i < n && cmp(s[i], target) == 0
+}
+
+// SortFunc sorts s in ascending order, as determined by the cmp function. The sort is not guaranteed to be
+// stable.
+func SortFunc[S ~[]E, E any](s S, cmp func(a, b E) int) {
+	sort.Slice(s, func(i, j int) bool { return cmp(s[i], s[j]) < 0 })
+}
+
+// SortStableFunc is like SortFunc, but keeps the original order of equal elements.
+func SortStableFunc[S ~[]E, E any](s S, cmp func(a, b E) int) {
+	sort.SliceStable(s, func(i, j int) bool { return cmp(s[i], s[j]) < 0 })
+}
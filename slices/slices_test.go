@@ -0,0 +1,150 @@
+package slices
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ids []int
+
+func TestCloneRetainsNamedType(t *testing.T) {
+	x := ids{1, 2, 3}
+	y := Clone(x)
+	assert.Equal(t, x, y)
+	assert.IsType(t, ids{}, y)
+}
+
+func TestCloneNil(t *testing.T) {
+	var x ids
+	assert.Nil(t, Clone(x))
+}
+
+func TestClip(t *testing.T) {
+	x := make([]int, 2, 10)
+	x[0], x[1] = 1, 2
+	y := Clip(x)
+	assert.Equal(t, []int{1, 2}, y)
+	assert.Equal(t, 2, cap(y))
+}
+
+func TestGrow(t *testing.T) {
+	x := []int{1, 2}
+	y := Grow(x, 10)
+	assert.Equal(t, []int{1, 2}, y)
+	assert.GreaterOrEqual(t, cap(y), 12)
+}
+
+func TestGrowNoopWhenCapacitySuffices(t *testing.T) {
+	x := make([]int, 2, 100)
+	x[0], x[1] = 1, 2
+	y := Grow(x, 5)
+	assert.Equal(t, []int{1, 2}, y)
+	assert.Equal(t, cap(x), cap(y))
+	assert.Same(t, &x[:1][0], &y[:1][0])
+}
+
+func TestGrowNegative(t *testing.T) {
+	assert.Panics(t, func() { Grow([]int{1, 2}, -1) })
+}
+
+func TestConcat(t *testing.T) {
+	got := Concat([]int{1, 2}, []int{3}, []int{}, []int{4, 5})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+}
+
+func TestDelete(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+	y := Delete(x, 1, 3)
+	assert.Equal(t, []int{0, 3, 4}, y)
+}
+
+func TestDeleteFunc(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+	y := DeleteFunc(x, func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{1, 3}, y)
+}
+
+func TestInsert(t *testing.T) {
+	x := []int{0, 1, 4, 5}
+	y := Insert(x, 2, 2, 3)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, y)
+}
+
+func TestInsertNoValues(t *testing.T) {
+	x := []int{0, 1, 2}
+	y := Insert(x, 1)
+	assert.Equal(t, []int{0, 1, 2}, y)
+}
+
+func TestReplace(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+	y := Replace(x, 1, 3, 9, 9, 9)
+	assert.Equal(t, []int{0, 9, 9, 9, 3, 4}, y)
+}
+
+func TestCompact(t *testing.T) {
+	x := []int{1, 1, 2, 2, 2, 3, 1}
+	y := Compact(x)
+	assert.Equal(t, []int{1, 2, 3, 1}, y)
+}
+
+func TestCompactFunc(t *testing.T) {
+	x := []string{"a", "A", "b", "B", "B"}
+	y := CompactFunc(x, func(a, b string) bool { return strings.EqualFold(a, b) })
+	assert.Equal(t, []string{"a", "b"}, y)
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal([]int{1, 2, 3}, []int{1, 2, 3}))
+	assert.False(t, Equal([]int{1, 2, 3}, []int{1, 2}))
+	assert.False(t, Equal([]int{1, 2, 3}, []int{1, 2, 4}))
+}
+
+func TestEqualFunc(t *testing.T) {
+	got := EqualFunc([]int{1, 2, 3}, []string{"1", "2", "3"}, func(a int, b string) bool {
+		return strconv.Itoa(a) == b
+	})
+	assert.True(t, got)
+}
+
+func TestIndex(t *testing.T) {
+	x := []int{4, 5, 6}
+	assert.Equal(t, 1, Index(x, 5))
+	assert.Equal(t, -1, Index(x, 9))
+}
+
+func TestContains(t *testing.T) {
+	x := []int{4, 5, 6}
+	assert.True(t, Contains(x, 5))
+	assert.False(t, Contains(x, 9))
+}
+
+func TestBinarySearch(t *testing.T) {
+	x := []int{1, 3, 5, 7, 9}
+
+	i, ok := BinarySearch(x, 5)
+	assert.Equal(t, 2, i)
+	assert.True(t, ok)
+
+	i, ok = BinarySearch(x, 6)
+	assert.Equal(t, 3, i)
+	assert.False(t, ok)
+}
+
+func TestSortFunc(t *testing.T) {
+	x := []int{5, 3, 1, 4, 2}
+	SortFunc(x, func(a, b int) int { return a - b })
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, x)
+}
+
+func TestSortStableFunc(t *testing.T) {
+	type pair struct {
+		key, original int
+	}
+	x := []pair{{1, 0}, {1, 1}, {0, 2}}
+	SortStableFunc(x, func(a, b pair) int { return a.key - b.key })
+	assert.Equal(t, []pair{{0, 2}, {1, 0}, {1, 1}}, x)
+}
@@ -1,12 +1,18 @@
 // Package slicetricks provides generic functions for performing (most of) the operations described in
 // https://github.com/golang/go/wiki/SliceTricks.
 //
+// The mutating functions here take a *[]T and mutate it in place, which avoids allocations but means named slice
+// types (e.g. type IDs []int) aren't preserved across calls. Callers who want an API shaped like the
+// standard library's slices package - value-returning, ~[]E constrained - should use the slicetricks/slices
+// subpackage instead.
+//
 // Ideas for future enhancement:
 // * add safe versions of methods that can fail (like Cut, Delete, Pop, etc), which return errors in failure cases.
-// * add a version of SortAndDeduplicate for non-comparable types.
 package slicetricks
 
 import (
+	"iter"
+	"slices"
 	"sort"
 )
 
@@ -16,24 +22,49 @@ func Copy[T any](a []T) []T {
 	return b
 }
 
-// Cut removes elements starting at start and ending at end (non-inclusive) from a.
+// Cut removes elements starting at start and ending at end (non-inclusive) from a. It panics if start or
+// end are out of range. The elements beyond the new length are zeroed so that they can be garbage
+// collected. For the value-returning equivalent (what the standard library's slices package calls
+// Delete(s, i, j)), see slicetricks/slices.Delete.
 func Cut[T any](a *[]T, start, end int) {
-	copy((*a)[start:], (*a)[end:])
-	for k, n := len(*a)-end+start, len(*a); k < n; k++ {
-		var zero T
+	_ = (*a)[start:end]
+
+	var zero T
+	n := copy((*a)[start:], (*a)[end:])
+	for k := start + n; k < len(*a); k++ {
 		(*a)[k] = zero
 	}
-	*a = (*a)[:len(*a)-end+start]
+	*a = (*a)[:start+n]
 }
 
-// Delete removes the i'th element from a.
+// Delete removes the i'th element from a. The vacated final element is zeroed so that it can be garbage
+// collected.
 func Delete[T any](a *[]T, i int) {
 	copy((*a)[i:], (*a)[i+1:])
-	var t T
-	(*a)[len(*a)-1] = t
+	var zero T
+	(*a)[len(*a)-1] = zero
 	*a = (*a)[:len(*a)-1]
 }
 
+// DeleteFunc removes any elements from a for which del returns true, preserving the order of the remaining
+// elements. The elements beyond the new length are zeroed so that they can be garbage collected.
+func DeleteFunc[T any](a *[]T, del func(t T) bool) {
+	n := 0
+	for _, x := range *a {
+		if !del(x) {
+			(*a)[n] = x
+			n++
+		}
+	}
+
+	var zero T
+	for k := n; k < len(*a); k++ {
+		(*a)[k] = zero
+	}
+
+	*a = (*a)[:n]
+}
+
 // DeleteUnordered is a faster alternative to Delete if you don't care about changing the order
 // of items in the slice.
 func DeleteUnordered[T any](a *[]T, i int) {
@@ -106,21 +137,52 @@ func PopFront[T any](a *[]T) T {
 
 /* "Additional Tricks" */
 
-// Batches returns batches of a with maximum size batchSize while performing minimal allocations. All elements in a will
-// be returned in a batch - the last batch may be smaller than batchSize.
+// Batches returns batches of a with maximum size batchSize. All elements in a will be returned in a batch -
+// the last batch may be smaller than batchSize. It returns no batches if batchSize <= 0.
+//
+// If you don't need the [][]T up front, BatchesSeq streams the same batches without allocating one.
 func Batches[T any](a []T, batchSize int) [][]T {
-	if len(a) == 0 {
-		return [][]T{}
+	batches := slices.Collect(BatchesSeq(a, batchSize))
+	if batches == nil {
+		batches = [][]T{}
 	}
+	return batches
+}
 
-	batches := make([][]T, 0, (len(a)+batchSize-1)/batchSize)
+// BatchesSeq is like Batches, but yields batches one at a time instead of allocating a [][]T up front. Each
+// yielded batch aliases the backing array of a. It yields nothing if batchSize <= 0.
+func BatchesSeq[T any](a []T, batchSize int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if batchSize <= 0 || len(a) == 0 {
+			return
+		}
 
-	for batchSize < len(a) {
-		a, batches = a[batchSize:], append(batches, a[0:batchSize:batchSize])
+		for batchSize < len(a) {
+			if !yield(a[0:batchSize:batchSize]) {
+				return
+			}
+			a = a[batchSize:]
+		}
+		yield(a)
 	}
-	batches = append(batches, a)
+}
 
-	return batches
+// BatchesSeq2 is like BatchesSeq, but also yields the index of each batch, starting at 0.
+func BatchesSeq2[T any](a []T, batchSize int) iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		if batchSize <= 0 || len(a) == 0 {
+			return
+		}
+
+		i := 0
+		for batchSize < len(a) {
+			if !yield(i, a[0:batchSize:batchSize]) {
+				return
+			}
+			a, i = a[batchSize:], i+1
+		}
+		yield(i, a)
+	}
 }
 
 // Filter removes any elements from a for which keep returns false.
@@ -163,30 +225,60 @@ func Reverse[T any](a []T) {
 }
 
 // SlidingWindow returns subarrays of a of size size, starting at increasing indices of a. For example,
-// SlidingWindow([0 1 2 3 4 5], 3) = [[0 1 2] [1 2 3] [2 3 4] [3 4 5]].
+// SlidingWindow([0 1 2 3 4 5], 3) = [[0 1 2] [1 2 3] [2 3 4] [3 4 5]]. It returns no windows if size <= 0.
+//
+// If you don't need the [][]T up front, SlidingWindowSeq streams the same windows without allocating one.
 func SlidingWindow[T any](a []T, size int) [][]T {
-	if len(a) == 0 {
-		return [][]T{}
+	windows := slices.Collect(SlidingWindowSeq(a, size))
+	if windows == nil {
+		windows = [][]T{}
 	}
+	return windows
+}
 
-	if len(a) <= size {
-		return [][]T{a}
-	}
+// SlidingWindowSeq is like SlidingWindow, but yields windows one at a time instead of allocating a [][]T up
+// front. Each yielded window aliases the backing array of a. It yields nothing if size <= 0.
+func SlidingWindowSeq[T any](a []T, size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 || len(a) == 0 {
+			return
+		}
 
-	// allocate slice at the precise size we need
-	r := make([][]T, 0, len(a)-size+1)
+		if len(a) <= size {
+			yield(a)
+			return
+		}
 
-	for i, j := 0, size; j <= len(a); i, j = i+1, j+1 {
-		r = append(r, a[i:j])
+		for i, j := 0, size; j <= len(a); i, j = i+1, j+1 {
+			if !yield(a[i:j]) {
+				return
+			}
+		}
 	}
+}
+
+// SlidingWindowSeq2 is like SlidingWindowSeq, but also yields the starting index of each window.
+func SlidingWindowSeq2[T any](a []T, size int) iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		if size <= 0 || len(a) == 0 {
+			return
+		}
 
-	return r
+		if len(a) <= size {
+			yield(0, a)
+			return
+		}
+
+		for i, j := 0, size; j <= len(a); i, j = i+1, j+1 {
+			if !yield(i, a[i:j]) {
+				return
+			}
+		}
+	}
 }
 
 // SortAndDeduplicate sorts the given slice and removes duplicate elements.
 func SortAndDeduplicate[T comparable](a *[]T, less func(i, j int) bool) {
-	// TODO: maybe another verson of this function for non-comparable types (e.g: passing an equals() function or using
-	// an interface) would be useful.
 	sort.SliceStable(*a, less)
 
 	j := 0
@@ -200,6 +292,44 @@ func SortAndDeduplicate[T comparable](a *[]T, less func(i, j int) bool) {
 	*a = (*a)[:j+1]
 }
 
+// SortAndDeduplicateFunc is a version of SortAndDeduplicate for non-comparable types: it sorts the given
+// slice using less, then removes duplicate elements as determined by eq.
+func SortAndDeduplicateFunc[T any](a *[]T, less func(i, j int) bool, eq func(a, b T) bool) {
+	sort.SliceStable(*a, less)
+	CompactFunc(a, eq)
+}
+
+// Compact removes consecutive runs of equal elements from a, keeping only the first element of each run, as
+// in the Unix uniq command. Unlike SortAndDeduplicate, Compact does not sort a first, so it only removes
+// duplicates that are already adjacent; sort a first if that's not guaranteed.
+func Compact[T comparable](a *[]T) {
+	CompactFunc(a, func(x, y T) bool { return x == y })
+}
+
+// CompactFunc is like Compact, but uses eq to compare elements, so it also works for non-comparable types.
+// The elements beyond the new length are zeroed so that they can be garbage collected.
+func CompactFunc[T any](a *[]T, eq func(a, b T) bool) {
+	if len(*a) < 2 {
+		return
+	}
+
+	j := 0
+	for i := 1; i < len(*a); i++ {
+		if !eq((*a)[j], (*a)[i]) {
+			j++
+			(*a)[j] = (*a)[i]
+		}
+	}
+	j++
+
+	var zero T
+	for k := j; k < len(*a); k++ {
+		(*a)[k] = zero
+	}
+
+	*a = (*a)[:j]
+}
+
 /* A couple more methods that aren't in SliceTricks but I couldn't help adding */
 
 // All returns true iff all elements in elem evaluate to true when passed to f.
@@ -1,7 +1,9 @@
 package slicetricks
 
 import (
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -36,6 +38,46 @@ func TestCutEnd(t *testing.T) {
 	assert.Equal(t, []int{0, 1}, x)
 }
 
+// waitFinalized calls register, then runs the garbage collector until the finalizer that register set up has
+// closed done, or fails the test if that doesn't happen within a second.
+func waitFinalized(t *testing.T, register func(done chan<- struct{})) {
+	t.Helper()
+
+	done := make(chan struct{})
+	register(done)
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		runtime.GC()
+		select {
+		case <-done:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	t.Fatal("expected removed element to become unreachable and be garbage collected")
+}
+
+func TestCutZeroesRemovedElementsForGC(t *testing.T) {
+	x := []*int{new(int), new(int), new(int), new(int)}
+	removed := x[2]
+
+	waitFinalized(t, func(done chan<- struct{}) {
+		runtime.SetFinalizer(removed, func(*int) { close(done) })
+		Cut(&x, 1, 3)
+	})
+}
+
+func TestDeleteZeroesRemovedElementForGC(t *testing.T) {
+	x := []*int{new(int), new(int), new(int), new(int)}
+	removed := x[3]
+
+	waitFinalized(t, func(done chan<- struct{}) {
+		runtime.SetFinalizer(removed, func(*int) { close(done) })
+		Delete(&x, 3)
+	})
+}
+
 func TestDeleteStart(t *testing.T) {
 	x := []int{0, 1, 2, 3}
 	Delete(&x, 0)
@@ -72,6 +114,12 @@ func TestDeleteUnorderedEnd(t *testing.T) {
 	assert.ElementsMatch(t, x, []int{0, 1, 2})
 }
 
+func TestDeleteFunc(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+	DeleteFunc(&x, func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{1, 3}, x)
+}
+
 func TestExpandStart(t *testing.T) {
 	x := []int{0, 1, 2, 3}
 	Expand(&x, 0, 3)
@@ -116,17 +164,9 @@ func TestFilter(t *testing.T) {
 	assert.Equal(t, []int{0, 2}, x)
 }
 
-func TestFilterZeroAlloc(t *testing.T) {
+func TestFilterNoGC(t *testing.T) {
 	x := []int{0, 1, 2, 3}
-	FilterZeroAlloc(&x, func(i int) bool {
-		return i%2 == 0
-	})
-	assert.Equal(t, []int{0, 2}, x)
-}
-
-func TestFilterZeroAllocNoGC(t *testing.T) {
-	x := []int{0, 1, 2, 3}
-	FilterZeroAllocNoGC(&x, func(i int) bool {
+	FilterNoGC(&x, func(i int) bool {
 		return i%2 == 0
 	})
 	assert.Equal(t, []int{0, 2}, x)
@@ -260,6 +300,54 @@ func TestBatchesEmpty(t *testing.T) {
 	assert.Equal(t, [][]int{}, batches)
 }
 
+func TestBatchesNonPositiveSize(t *testing.T) {
+	x := []int{0, 1, 2}
+	batches := Batches(x, 0)
+	assert.Equal(t, [][]int{}, batches)
+}
+
+func TestBatchesSeq(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	var batches [][]int
+	for b := range BatchesSeq(x, 3) {
+		batches = append(batches, b)
+	}
+	assert.Equal(t, [][]int{{0, 1, 2}, {3, 4, 5}, {6, 7}}, batches)
+}
+
+func TestBatchesSeqEarlyBreak(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4, 5}
+
+	var batches [][]int
+	for b := range BatchesSeq(x, 2) {
+		batches = append(batches, b)
+		if len(batches) == 1 {
+			break
+		}
+	}
+	assert.Equal(t, [][]int{{0, 1}}, batches)
+}
+
+func TestBatchesSeqNonPositiveSize(t *testing.T) {
+	for range BatchesSeq([]int{0, 1, 2}, 0) {
+		t.Fatal("expected no batches to be yielded")
+	}
+}
+
+func TestBatchesSeq2(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+
+	var indices []int
+	var batches [][]int
+	for i, b := range BatchesSeq2(x, 2) {
+		indices = append(indices, i)
+		batches = append(batches, b)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}, {4}}, batches)
+}
+
 func TestReverse(t *testing.T) {
 	x := []int{0, 1, 2, 3, 4}
 	Reverse(x)
@@ -284,12 +372,52 @@ func TestSlidingWindowEmpty(t *testing.T) {
 	assert.Equal(t, [][]int{}, windows)
 }
 
+func TestSlidingWindowNonPositiveSize(t *testing.T) {
+	x := []int{0, 1, 2}
+	windows := SlidingWindow(x, 0)
+	assert.Equal(t, [][]int{}, windows)
+}
+
 func TestSlidingWindowBiggerThanSlice(t *testing.T) {
 	x := []int{0, 1}
 	windows := SlidingWindow(x, 5)
 	assert.Equal(t, [][]int{{0, 1}}, windows)
 }
 
+func TestSlidingWindowSeq(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+
+	var windows [][]int
+	for w := range SlidingWindowSeq(x, 3) {
+		windows = append(windows, w)
+	}
+	assert.Equal(t, [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}, windows)
+}
+
+func TestSlidingWindowSeqEarlyBreak(t *testing.T) {
+	x := []int{0, 1, 2, 3, 4}
+
+	var windows [][]int
+	for w := range SlidingWindowSeq(x, 3) {
+		windows = append(windows, w)
+		break
+	}
+	assert.Equal(t, [][]int{{0, 1, 2}}, windows)
+}
+
+func TestSlidingWindowSeq2(t *testing.T) {
+	x := []int{0, 1, 2, 3}
+
+	var indices []int
+	var windows [][]int
+	for i, w := range SlidingWindowSeq2(x, 2) {
+		indices = append(indices, i)
+		windows = append(windows, w)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, [][]int{{0, 1}, {1, 2}, {2, 3}}, windows)
+}
+
 func TestSortAndDeduplicate(t *testing.T) {
 	x := []int{9, 3, 3, 4, 6, 3, 6, 9, 3, 5}
 	SortAndDeduplicate(&x, func(i, j int) bool {
@@ -298,6 +426,34 @@ func TestSortAndDeduplicate(t *testing.T) {
 	assert.Equal(t, []int{3, 4, 5, 6, 9}, x)
 }
 
+// tagged is a non-comparable type (it embeds a slice), used to exercise the *Func variants that don't
+// require T to be comparable.
+type tagged struct {
+	key  int
+	tags []string
+}
+
+func TestSortAndDeduplicateFunc(t *testing.T) {
+	x := []tagged{{9, nil}, {3, nil}, {3, nil}, {4, nil}, {3, nil}}
+	SortAndDeduplicateFunc(&x,
+		func(i, j int) bool { return x[i].key < x[j].key },
+		func(a, b tagged) bool { return a.key == b.key },
+	)
+	assert.Equal(t, []tagged{{3, nil}, {4, nil}, {9, nil}}, x)
+}
+
+func TestCompact(t *testing.T) {
+	x := []int{1, 1, 2, 2, 2, 3, 1}
+	Compact(&x)
+	assert.Equal(t, []int{1, 2, 3, 1}, x)
+}
+
+func TestCompactFunc(t *testing.T) {
+	x := []tagged{{1, nil}, {1, nil}, {2, nil}, {1, nil}}
+	CompactFunc(&x, func(a, b tagged) bool { return a.key == b.key })
+	assert.Equal(t, []tagged{{1, nil}, {2, nil}, {1, nil}}, x)
+}
+
 func TestAny(t *testing.T) {
 	x := []int{2, 3, 4, 5}
 	assert.Equal(t, true, Any(x, func(elem int) bool {